@@ -0,0 +1,181 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalJSON encodes the map as a JSON object, writing keys in their
+// current insertion order. K must be string or implement
+// encoding.TextMarshaler; any other key type returns an error as soon as the
+// map holds a key (an empty map marshals to {} whatever its key type).
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	m.ensureInit()
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range *m.ll {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		keyText, err := marshalTextKey(key)
+		if err != nil {
+			return nil, err
+		}
+		keyBytes, err := json.Marshal(keyText)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(keyBytes)
+		buf.WriteByte(':')
+		valueBytes, err := json.Marshal(m.kv[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(valueBytes)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object into the map, preserving the key order
+// in which they appear in the document. K must be string or implement
+// encoding.TextUnmarshaler.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	m.ensureInit()
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("orderedmap: expected JSON object, got %v", tok)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		keyStr, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("orderedmap: expected JSON object key, got %v", tok)
+		}
+		key, err := unmarshalTextKey[K](keyStr)
+		if err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		m.Set(key, value)
+	}
+
+	_, err = dec.Token() // closing '}'
+	return err
+}
+
+// MarshalYAML encodes the map as a YAML mapping node, writing keys in their
+// current insertion order. K must be string or implement
+// encoding.TextMarshaler.
+func (m OrderedMap[K, V]) MarshalYAML() (interface{}, error) {
+	m.ensureInit()
+
+	node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, key := range *m.ll {
+		keyText, err := marshalTextKey(key)
+		if err != nil {
+			return nil, err
+		}
+		keyNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: keyText}
+		valueNode := &yaml.Node{}
+		if err := valueNode.Encode(m.kv[key]); err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, keyNode, valueNode)
+	}
+	return node, nil
+}
+
+// UnmarshalYAML decodes a YAML mapping into the map, preserving the document
+// order of its key/value pairs. K must be string or implement
+// encoding.TextUnmarshaler.
+func (m *OrderedMap[K, V]) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("orderedmap: expected a YAML mapping, got kind %d", node.Kind)
+	}
+	m.ensureInit()
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+
+		var keyStr string
+		if err := keyNode.Decode(&keyStr); err != nil {
+			return err
+		}
+		key, err := unmarshalTextKey[K](keyStr)
+		if err != nil {
+			return err
+		}
+
+		var value V
+		if err := valueNode.Decode(&value); err != nil {
+			return err
+		}
+		m.Set(key, value)
+	}
+	return nil
+}
+
+// ensureInit lazily allocates the backing map and order slice so that an
+// OrderedMap can be the target of json.Unmarshal or yaml.Unmarshal without
+// first being constructed via New(Sync)?OrderedMap.
+func (m *OrderedMap[K, V]) ensureInit() {
+	if m.kv == nil {
+		m.kv = map[K]V{}
+	}
+	if m.ll == nil {
+		m.ll = new([]K)
+	}
+}
+
+// marshalTextKey renders a map key as text for JSON/YAML output. K must be
+// string or implement encoding.TextMarshaler.
+func marshalTextKey[K comparable](key K) (string, error) {
+	switch k := any(key).(type) {
+	case string:
+		return k, nil
+	case encoding.TextMarshaler:
+		text, err := k.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	default:
+		return "", fmt.Errorf("orderedmap: key type %T must be string or implement encoding.TextMarshaler", key)
+	}
+}
+
+// unmarshalTextKey parses a map key from text. K must be string or implement
+// encoding.TextUnmarshaler (via a pointer receiver).
+func unmarshalTextKey[K comparable](text string) (K, error) {
+	var key K
+	switch any(key).(type) {
+	case string:
+		return any(text).(K), nil
+	default:
+		if u, ok := any(&key).(encoding.TextUnmarshaler); ok {
+			if err := u.UnmarshalText([]byte(text)); err != nil {
+				return key, err
+			}
+			return key, nil
+		}
+		return key, fmt.Errorf("orderedmap: key type %T must be string or implement encoding.TextUnmarshaler", key)
+	}
+}
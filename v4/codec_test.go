@@ -0,0 +1,90 @@
+package orderedmap
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestOrderedMapJSONRoundTrip(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	if want := `{"c":3,"a":1,"b":2}`; string(data) != want {
+		t.Fatalf("Marshal = %s; want %s", data, want)
+	}
+
+	var got OrderedMap[string, int]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	var keys []string
+	for k := range got.All() {
+		keys = append(keys, k)
+	}
+	wantKeys := []string{"c", "a", "b"}
+	if len(keys) != len(wantKeys) {
+		t.Fatalf("got keys %v; want %v", keys, wantKeys)
+	}
+	for i, k := range wantKeys {
+		if keys[i] != k {
+			t.Fatalf("got keys %v; want %v", keys, wantKeys)
+		}
+	}
+	if v, ok := got.Get("b"); !ok || v != 2 {
+		t.Fatalf("Get(b) = %v, %v; want 2, true", v, ok)
+	}
+}
+
+func TestOrderedMapMarshalZeroValue(t *testing.T) {
+	type config struct {
+		Values OrderedMap[string, int]
+	}
+
+	var c config
+	if _, err := json.Marshal(&c.Values); err != nil {
+		t.Fatalf("json.Marshal on zero-value OrderedMap panicked or errored: %v", err)
+	}
+	if _, err := yaml.Marshal(&c.Values); err != nil {
+		t.Fatalf("yaml.Marshal on zero-value OrderedMap panicked or errored: %v", err)
+	}
+}
+
+func TestOrderedMapYAMLRoundTrip(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var got OrderedMap[string, int]
+	if err := yaml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	var keys []string
+	for k := range got.All() {
+		keys = append(keys, k)
+	}
+	wantKeys := []string{"c", "a", "b"}
+	if len(keys) != len(wantKeys) {
+		t.Fatalf("got keys %v; want %v", keys, wantKeys)
+	}
+	for i, k := range wantKeys {
+		if keys[i] != k {
+			t.Fatalf("got keys %v; want %v", keys, wantKeys)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package orderedmap
+
+// CycleIterator walks a map's keys in order and wraps back to the front
+// after the last one, for round-robin traversal (e.g. fair scheduling over
+// a map's keys). Unlike the iter.Seq2 iterators, it never terminates on its
+// own and keeps state between calls to Next.
+//
+// A CycleIterator obtained from Cycle walks the live map: if keys are
+// deleted while cycling, Next may skip or repeat elements as the underlying
+// slice shifts. Use CycleSnapshot for a copy of the key/value pairs taken up
+// front (both, not just the keys), which is a true snapshot: it is immune
+// to later Set or Delete calls on the map and, because it never touches the
+// map again, safe to keep cycling after the map (or a SyncOrderedMap wrapping
+// it) has moved on without a lock held.
+type CycleIterator[K comparable, V any] struct {
+	m    *OrderedMap[K, V] // nil once snapshotted; vals is the mode switch
+	keys []K
+	vals []V
+	i    int
+}
+
+// Cycle returns a CycleIterator over the map's live key order.
+func (m OrderedMap[K, V]) Cycle() *CycleIterator[K, V] {
+	return &CycleIterator[K, V]{m: &m}
+}
+
+// CycleSnapshot returns a CycleIterator over a copy of the map's current
+// key/value pairs, unaffected by subsequent Set or Delete calls on the map.
+func (m OrderedMap[K, V]) CycleSnapshot() *CycleIterator[K, V] {
+	keys := make([]K, len(*m.ll))
+	vals := make([]V, len(*m.ll))
+	for i, key := range *m.ll {
+		keys[i] = key
+		vals[i] = m.kv[key]
+	}
+	return &CycleIterator[K, V]{keys: keys, vals: vals}
+}
+
+// Next returns the next key/value pair, wrapping to the front after the
+// last element. ok is false only when the map (or snapshot) is empty.
+func (c *CycleIterator[K, V]) Next() (key K, value V, ok bool) {
+	if c.vals != nil {
+		if len(c.keys) == 0 {
+			return key, value, false
+		}
+		if c.i >= len(c.keys) {
+			c.i = 0
+		}
+		key, value = c.keys[c.i], c.vals[c.i]
+		c.i++
+		return key, value, true
+	}
+
+	ll := *c.m.ll
+	if len(ll) == 0 {
+		return key, value, false
+	}
+	if c.i >= len(ll) {
+		c.i = 0
+	}
+	key = ll[c.i]
+	c.i++
+	return key, c.m.kv[key], true
+}
+
+// Reset returns the iterator to the front. It returns false only if the map
+// (or snapshot) is empty.
+func (c *CycleIterator[K, V]) Reset() bool {
+	c.i = 0
+	if c.vals != nil {
+		return len(c.keys) > 0
+	}
+	return len(*c.m.ll) > 0
+}
@@ -0,0 +1,73 @@
+package orderedmap
+
+import "slices"
+
+// Element is a cursor over a single key/value pair in an OrderedMap,
+// obtained via Front, Back, or by walking Next/Prev from another Element.
+// Value is a snapshot taken when the Element was produced; call Get on the
+// originating map if you need the current value.
+type Element[K comparable, V any] struct {
+	Key   K
+	Value V
+
+	m *OrderedMap[K, V]
+	i int // last known index of Key in *m.ll; re-verified before use
+}
+
+// Front returns the Element at the front (oldest Set element) of the map, or
+// nil if the map is empty.
+func (m OrderedMap[K, V]) Front() *Element[K, V] {
+	if len(*m.ll) == 0 {
+		return nil
+	}
+	key := (*m.ll)[0]
+	return &Element[K, V]{Key: key, Value: m.kv[key], m: &m, i: 0}
+}
+
+// Back returns the Element at the back (most recent Set element) of the
+// map, or nil if the map is empty.
+func (m OrderedMap[K, V]) Back() *Element[K, V] {
+	if len(*m.ll) == 0 {
+		return nil
+	}
+	i := len(*m.ll) - 1
+	key := (*m.ll)[i]
+	return &Element[K, V]{Key: key, Value: m.kv[key], m: &m, i: i}
+}
+
+// index returns e's current position in *e.m.ll. The index stashed on e is
+// trusted (and returned in O(1)) as long as it still points at e.Key; it
+// only falls back to a full slices.Index scan once e.Key has moved, e.g.
+// because an earlier key was deleted.
+func (e *Element[K, V]) index() int {
+	ll := *e.m.ll
+	if e.i >= 0 && e.i < len(ll) && ll[e.i] == e.Key {
+		return e.i
+	}
+	return slices.Index(ll, e.Key)
+}
+
+// Next returns the Element following e, or nil if e is the last element or
+// its key has been deleted from the map since e was obtained. A Set or
+// Delete of any other key does not invalidate e, only deleting e's own key
+// does.
+func (e *Element[K, V]) Next() *Element[K, V] {
+	i := e.index()
+	if i < 0 || i+1 >= len(*e.m.ll) {
+		return nil
+	}
+	key := (*e.m.ll)[i+1]
+	return &Element[K, V]{Key: key, Value: e.m.kv[key], m: e.m, i: i + 1}
+}
+
+// Prev returns the Element preceding e, or nil if e is the first element or
+// its key has been deleted from the map since e was obtained. See Next for
+// the invalidation rules.
+func (e *Element[K, V]) Prev() *Element[K, V] {
+	i := e.index()
+	if i <= 0 {
+		return nil
+	}
+	key := (*e.m.ll)[i-1]
+	return &Element[K, V]{Key: key, Value: e.m.kv[key], m: e.m, i: i - 1}
+}
@@ -0,0 +1,133 @@
+package orderedmap
+
+import "testing"
+
+func TestElementFrontBackEmptyMap(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	if e := m.Front(); e != nil {
+		t.Fatalf("Front() on empty map = %v; want nil", e)
+	}
+	if e := m.Back(); e != nil {
+		t.Fatalf("Back() on empty map = %v; want nil", e)
+	}
+}
+
+func TestElementWalkForward(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	var keys []string
+	var values []int
+	for e := m.Front(); e != nil; e = e.Next() {
+		keys = append(keys, e.Key)
+		values = append(values, e.Value)
+	}
+
+	wantKeys := []string{"a", "b", "c"}
+	wantValues := []int{1, 2, 3}
+	if len(keys) != len(wantKeys) {
+		t.Fatalf("walked keys %v; want %v", keys, wantKeys)
+	}
+	for i := range wantKeys {
+		if keys[i] != wantKeys[i] || values[i] != wantValues[i] {
+			t.Fatalf("walked (%v, %v); want (%v, %v)", keys, values, wantKeys, wantValues)
+		}
+	}
+}
+
+func TestElementWalkBackward(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	var keys []string
+	for e := m.Back(); e != nil; e = e.Prev() {
+		keys = append(keys, e.Key)
+	}
+
+	want := []string{"c", "b", "a"}
+	if len(keys) != len(want) {
+		t.Fatalf("walked keys %v; want %v", keys, want)
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Fatalf("walked keys %v; want %v", keys, want)
+		}
+	}
+}
+
+func TestElementNextPastBackIsNil(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+
+	e := m.Back()
+	if e == nil {
+		t.Fatalf("Back() = nil; want an element")
+	}
+	if n := e.Next(); n != nil {
+		t.Fatalf("Next() past the back = %v; want nil", n)
+	}
+}
+
+func TestElementPrevPastFrontIsNil(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+
+	e := m.Front()
+	if e == nil {
+		t.Fatalf("Front() = nil; want an element")
+	}
+	if p := e.Prev(); p != nil {
+		t.Fatalf("Prev() past the front = %v; want nil", p)
+	}
+}
+
+func TestElementInvalidatedByOwnDelete(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	e := m.Front().Next() // cursor on "b"
+	if e == nil || e.Key != "b" {
+		t.Fatalf("expected cursor on b, got %v", e)
+	}
+
+	m.Delete("b")
+
+	if n := e.Next(); n != nil {
+		t.Fatalf("Next() after deleting e's own key = %v; want nil", n)
+	}
+	if p := e.Prev(); p != nil {
+		t.Fatalf("Prev() after deleting e's own key = %v; want nil", p)
+	}
+}
+
+func TestElementSurvivesUnrelatedDelete(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+	m.Set("d", 4)
+
+	e := m.Front().Next() // cursor on "b", index 1
+	if e == nil || e.Key != "b" {
+		t.Fatalf("expected cursor on b, got %v", e)
+	}
+
+	// Deleting an earlier key shifts every later index, including the stale
+	// one cached on e; Next/Prev must recover by re-locating e.Key.
+	m.Delete("a")
+
+	n := e.Next()
+	if n == nil || n.Key != "c" {
+		t.Fatalf("Next() after unrelated delete = %v; want c", n)
+	}
+	n = n.Next()
+	if n == nil || n.Key != "d" {
+		t.Fatalf("Next().Next() after unrelated delete = %v; want d", n)
+	}
+}
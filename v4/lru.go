@@ -0,0 +1,94 @@
+package orderedmap
+
+// LRUMap is a bounded-capacity, least-recently-used map built on top of
+// OrderedMap. Set moves the touched key to the back; once the map grows
+// past its capacity, the front (least recently used) key is evicted.
+type LRUMap[K comparable, V any] struct {
+	// OnEvict, if set, is called with the key and value of every entry
+	// evicted, whether to make room for a new one or by Resize.
+	OnEvict func(K, V)
+
+	m        *OrderedMap[K, V]
+	capacity int
+}
+
+// NewLRUMap creates an LRUMap that holds at most capacity entries. A
+// capacity of 0 or less disables eviction.
+func NewLRUMap[K comparable, V any](capacity int) *LRUMap[K, V] {
+	return &LRUMap[K, V]{m: NewOrderedMap[K, V](), capacity: capacity}
+}
+
+// Set will set (or replace) a value for a key and mark it as most recently
+// used. If adding the key pushes the map past its capacity, the least
+// recently used entry is evicted and passed to OnEvict.
+func (l *LRUMap[K, V]) Set(key K, value V) {
+	if l.m.Has(key) {
+		l.m.Set(key, value)
+		l.m.MoveToBack(key)
+		return
+	}
+
+	l.m.Set(key, value)
+	if l.capacity > 0 && l.m.Len() > l.capacity {
+		l.evictFront()
+	}
+}
+
+// Get returns the value for a key and marks it as most recently used. If
+// the key does not exist, the second return parameter will be false. Use
+// Peek to read a value without affecting its recency.
+func (l *LRUMap[K, V]) Get(key K) (value V, ok bool) {
+	value, ok = l.m.Get(key)
+	if ok {
+		l.m.MoveToBack(key)
+	}
+	return
+}
+
+// Peek returns the value for a key without marking it as most recently
+// used.
+func (l *LRUMap[K, V]) Peek(key K) (value V, ok bool) {
+	return l.m.Get(key)
+}
+
+// Has checks if a key exists in the map, without affecting its recency.
+func (l *LRUMap[K, V]) Has(key K) bool {
+	return l.m.Has(key)
+}
+
+// Delete removes a key from the map. It returns true if the key was removed
+// (the key did exist). Unlike eviction, this does not call OnEvict.
+func (l *LRUMap[K, V]) Delete(key K) bool {
+	return l.m.Delete(key)
+}
+
+// Len returns the number of elements currently in the map.
+func (l *LRUMap[K, V]) Len() int {
+	return l.m.Len()
+}
+
+// Capacity returns the map's current maximum size.
+func (l *LRUMap[K, V]) Capacity() int {
+	return l.capacity
+}
+
+// Resize changes the maximum size of the map, evicting from the front (via
+// OnEvict) until the map fits within the new bound. A capacity of 0 or less
+// disables eviction.
+func (l *LRUMap[K, V]) Resize(capacity int) {
+	l.capacity = capacity
+	for l.capacity > 0 && l.m.Len() > l.capacity {
+		l.evictFront()
+	}
+}
+
+func (l *LRUMap[K, V]) evictFront() {
+	front := l.m.Front()
+	if front == nil {
+		return
+	}
+	l.m.Delete(front.Key)
+	if l.OnEvict != nil {
+		l.OnEvict(front.Key, front.Value)
+	}
+}
@@ -0,0 +1,76 @@
+package orderedmap
+
+import "testing"
+
+func TestLRUMapEvictsLeastRecentlyUsed(t *testing.T) {
+	var evicted []string
+	l := NewLRUMap[string, int](2)
+	l.OnEvict = func(k string, v int) { evicted = append(evicted, k) }
+
+	l.Set("a", 1)
+	l.Set("b", 2)
+	l.Set("c", 3) // evicts "a", the least recently used
+
+	if len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("evicted = %v; want [a]", evicted)
+	}
+	if l.Has("a") {
+		t.Fatalf("a should have been evicted")
+	}
+	if l.Len() != 2 {
+		t.Fatalf("Len() = %d; want 2", l.Len())
+	}
+}
+
+func TestLRUMapGetPromotes(t *testing.T) {
+	var evicted []string
+	l := NewLRUMap[string, int](2)
+	l.OnEvict = func(k string, v int) { evicted = append(evicted, k) }
+
+	l.Set("a", 1)
+	l.Set("b", 2)
+	l.Get("a")    // touch "a", making "b" the least recently used
+	l.Set("c", 3) // evicts "b"
+
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Fatalf("evicted = %v; want [b]", evicted)
+	}
+	if !l.Has("a") {
+		t.Fatalf("a should still be present after being touched")
+	}
+}
+
+func TestLRUMapPeekDoesNotPromote(t *testing.T) {
+	var evicted []string
+	l := NewLRUMap[string, int](2)
+	l.OnEvict = func(k string, v int) { evicted = append(evicted, k) }
+
+	l.Set("a", 1)
+	l.Set("b", 2)
+	l.Peek("a")   // should not affect recency
+	l.Set("c", 3) // evicts "a", since Peek didn't promote it
+
+	if len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("evicted = %v; want [a]", evicted)
+	}
+}
+
+func TestLRUMapResize(t *testing.T) {
+	var evicted []string
+	l := NewLRUMap[string, int](3)
+	l.OnEvict = func(k string, v int) { evicted = append(evicted, k) }
+
+	l.Set("a", 1)
+	l.Set("b", 2)
+	l.Set("c", 3)
+
+	l.Resize(1) // should evict down to just "c"
+
+	want := []string{"a", "b"}
+	if len(evicted) != len(want) || evicted[0] != want[0] || evicted[1] != want[1] {
+		t.Fatalf("evicted = %v; want %v", evicted, want)
+	}
+	if l.Len() != 1 || !l.Has("c") {
+		t.Fatalf("expected only c to remain, Len() = %d", l.Len())
+	}
+}
@@ -0,0 +1,97 @@
+package orderedmap
+
+import "slices"
+
+// IndexOf returns the position of key in the map's iteration order, or -1 if
+// the key does not exist.
+func (m OrderedMap[K, V]) IndexOf(key K) int {
+	return slices.Index(*m.ll, key)
+}
+
+// InsertAt inserts a new key/value pair at the given index, shifting later
+// elements back. It returns false if key already exists or index is out of
+// range (index must be in [0, Len()]).
+func (m OrderedMap[K, V]) InsertAt(index int, key K, value V) bool {
+	if _, alreadyExists := m.kv[key]; alreadyExists {
+		return false
+	}
+	if index < 0 || index > len(*m.ll) {
+		return false
+	}
+
+	m.kv[key] = value
+	*m.ll = slices.Insert(*m.ll, index, key)
+	return true
+}
+
+// MoveToFront moves an existing key to the front of the iteration order. It
+// returns false if the key does not exist.
+//
+// MoveToFront, MoveToBack, MoveBefore, and MoveAfter are all O(n) since they
+// reorder a plain slice; if a workload does many reorders relative to its
+// size, a linked-list-based representation would be a better fit.
+func (m OrderedMap[K, V]) MoveToFront(key K) bool {
+	i := slices.Index(*m.ll, key)
+	if i < 0 {
+		return false
+	}
+	*m.ll = slices.Delete(*m.ll, i, i+1)
+	*m.ll = slices.Insert(*m.ll, 0, key)
+	return true
+}
+
+// MoveToBack moves an existing key to the back of the iteration order. It
+// returns false if the key does not exist.
+func (m OrderedMap[K, V]) MoveToBack(key K) bool {
+	i := slices.Index(*m.ll, key)
+	if i < 0 {
+		return false
+	}
+	*m.ll = slices.Delete(*m.ll, i, i+1)
+	*m.ll = append(*m.ll, key)
+	return true
+}
+
+// MoveBefore moves key so that it immediately precedes mark in the
+// iteration order. It returns false if either key does not exist.
+func (m OrderedMap[K, V]) MoveBefore(key, mark K) bool {
+	if key == mark {
+		return false
+	}
+	ki := slices.Index(*m.ll, key)
+	if ki < 0 || !m.Has(mark) {
+		return false
+	}
+	*m.ll = slices.Delete(*m.ll, ki, ki+1)
+	mi := slices.Index(*m.ll, mark)
+	*m.ll = slices.Insert(*m.ll, mi, key)
+	return true
+}
+
+// MoveAfter moves key so that it immediately follows mark in the iteration
+// order. It returns false if either key does not exist.
+func (m OrderedMap[K, V]) MoveAfter(key, mark K) bool {
+	if key == mark {
+		return false
+	}
+	ki := slices.Index(*m.ll, key)
+	if ki < 0 || !m.Has(mark) {
+		return false
+	}
+	*m.ll = slices.Delete(*m.ll, ki, ki+1)
+	mi := slices.Index(*m.ll, mark)
+	*m.ll = slices.Insert(*m.ll, mi+1, key)
+	return true
+}
+
+// Swap exchanges the positions of two existing keys in the iteration order.
+// It returns false if either key does not exist.
+func (m OrderedMap[K, V]) Swap(a, b K) bool {
+	ai := slices.Index(*m.ll, a)
+	bi := slices.Index(*m.ll, b)
+	if ai < 0 || bi < 0 {
+		return false
+	}
+	(*m.ll)[ai], (*m.ll)[bi] = (*m.ll)[bi], (*m.ll)[ai]
+	return true
+}
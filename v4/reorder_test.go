@@ -0,0 +1,147 @@
+package orderedmap
+
+import (
+	"slices"
+	"testing"
+)
+
+func keyOrder[K comparable, V any](m *OrderedMap[K, V]) []K {
+	return slices.Collect(m.Keys())
+}
+
+func TestIndexOf(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	if i := m.IndexOf("a"); i != 0 {
+		t.Fatalf("IndexOf(a) = %d; want 0", i)
+	}
+	if i := m.IndexOf("b"); i != 1 {
+		t.Fatalf("IndexOf(b) = %d; want 1", i)
+	}
+	if i := m.IndexOf("missing"); i != -1 {
+		t.Fatalf("IndexOf(missing) = %d; want -1", i)
+	}
+}
+
+func TestInsertAt(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("c", 3)
+
+	if !m.InsertAt(1, "b", 2) {
+		t.Fatalf("InsertAt(1, b, 2) should succeed")
+	}
+	if got, want := keyOrder(m), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("order = %v; want %v", got, want)
+	}
+	if v, ok := m.Get("b"); !ok || v != 2 {
+		t.Fatalf("Get(b) = %v, %v; want 2, true", v, ok)
+	}
+
+	if m.InsertAt(0, "a", 99) {
+		t.Fatalf("InsertAt with an existing key should fail")
+	}
+	if m.InsertAt(-1, "z", 0) {
+		t.Fatalf("InsertAt with a negative index should fail")
+	}
+	if m.InsertAt(100, "z", 0) {
+		t.Fatalf("InsertAt with an out-of-range index should fail")
+	}
+	if !m.InsertAt(m.Len(), "z", 26) {
+		t.Fatalf("InsertAt(Len(), ...) should succeed (append at the end)")
+	}
+	if got, want := keyOrder(m), []string{"a", "b", "c", "z"}; !slices.Equal(got, want) {
+		t.Fatalf("order = %v; want %v", got, want)
+	}
+}
+
+func TestMoveToFrontAndBack(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	if !m.MoveToBack("a") {
+		t.Fatalf("MoveToBack(a) should succeed")
+	}
+	if got, want := keyOrder(m), []string{"b", "c", "a"}; !slices.Equal(got, want) {
+		t.Fatalf("order = %v; want %v", got, want)
+	}
+
+	if !m.MoveToFront("a") {
+		t.Fatalf("MoveToFront(a) should succeed")
+	}
+	if got, want := keyOrder(m), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Fatalf("order = %v; want %v", got, want)
+	}
+
+	if m.MoveToFront("missing") {
+		t.Fatalf("MoveToFront(missing) should fail")
+	}
+	if m.MoveToBack("missing") {
+		t.Fatalf("MoveToBack(missing) should fail")
+	}
+}
+
+func TestMoveBeforeAndAfter(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	if !m.MoveBefore("c", "a") {
+		t.Fatalf("MoveBefore(c, a) should succeed")
+	}
+	if got, want := keyOrder(m), []string{"c", "a", "b"}; !slices.Equal(got, want) {
+		t.Fatalf("order = %v; want %v", got, want)
+	}
+
+	if !m.MoveAfter("c", "a") {
+		t.Fatalf("MoveAfter(c, a) should succeed")
+	}
+	if got, want := keyOrder(m), []string{"a", "c", "b"}; !slices.Equal(got, want) {
+		t.Fatalf("order = %v; want %v", got, want)
+	}
+
+	if m.MoveBefore("a", "a") {
+		t.Fatalf("MoveBefore with key == mark should fail")
+	}
+	if m.MoveAfter("a", "a") {
+		t.Fatalf("MoveAfter with key == mark should fail")
+	}
+	if m.MoveBefore("a", "missing") {
+		t.Fatalf("MoveBefore with a missing mark should fail")
+	}
+	if m.MoveAfter("a", "missing") {
+		t.Fatalf("MoveAfter with a missing mark should fail")
+	}
+	if m.MoveBefore("missing", "a") {
+		t.Fatalf("MoveBefore with a missing key should fail")
+	}
+	if m.MoveAfter("missing", "a") {
+		t.Fatalf("MoveAfter with a missing key should fail")
+	}
+}
+
+func TestSwap(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	if !m.Swap("a", "c") {
+		t.Fatalf("Swap(a, c) should succeed")
+	}
+	if got, want := keyOrder(m), []string{"c", "b", "a"}; !slices.Equal(got, want) {
+		t.Fatalf("order = %v; want %v", got, want)
+	}
+	if v, ok := m.Get("a"); !ok || v != 1 {
+		t.Fatalf("Get(a) = %v, %v; want 1, true (Swap must not touch values)", v, ok)
+	}
+
+	if m.Swap("a", "missing") {
+		t.Fatalf("Swap with a missing key should fail")
+	}
+}
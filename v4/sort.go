@@ -0,0 +1,30 @@
+package orderedmap
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Sort reorders m's keys into ascending order in place. It is a free
+// function rather than a method because it requires K to satisfy
+// cmp.Ordered, a stricter constraint than the comparable OrderedMap itself
+// needs.
+func Sort[K cmp.Ordered, V any](m *OrderedMap[K, V]) {
+	slices.Sort(*m.ll)
+}
+
+// SortFunc reorders m's keys in place using less as the comparison
+// function, in the style of slices.SortFunc: less(a, b) should return a
+// negative number when a sorts before b, zero when they're equivalent, and
+// a positive number when a sorts after b.
+func SortFunc[K comparable, V any](m *OrderedMap[K, V], less func(a, b K) int) {
+	slices.SortFunc(*m.ll, less)
+}
+
+// SortByValue reorders m's keys in place by comparing their values with
+// less, in the style of slices.SortFunc.
+func SortByValue[K comparable, V any](m *OrderedMap[K, V], less func(a, b V) int) {
+	slices.SortFunc(*m.ll, func(a, b K) int {
+		return less(m.kv[a], m.kv[b])
+	})
+}
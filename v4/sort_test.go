@@ -0,0 +1,50 @@
+package orderedmap
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	m.Set(3, "c")
+	m.Set(1, "a")
+	m.Set(4, "d")
+	m.Set(2, "b")
+
+	Sort(m)
+
+	if got, want := keyOrder(m), []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Fatalf("order = %v; want %v", got, want)
+	}
+	if v, ok := m.Get(3); !ok || v != "c" {
+		t.Fatalf("Get(3) = %v, %v; want c, true (Sort must not touch values)", v, ok)
+	}
+}
+
+func TestSortFunc(t *testing.T) {
+	m := NewOrderedMap[int, string]()
+	m.Set(1, "a")
+	m.Set(2, "b")
+	m.Set(3, "c")
+
+	SortFunc(m, func(a, b int) int { return cmp.Compare(b, a) }) // descending
+
+	if got, want := keyOrder(m), []int{3, 2, 1}; !slices.Equal(got, want) {
+		t.Fatalf("order = %v; want %v", got, want)
+	}
+}
+
+func TestSortByValue(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 3)
+	m.Set("b", 1)
+	m.Set("c", 2)
+
+	SortByValue(m, func(a, b int) int { return cmp.Compare(a, b) })
+
+	if got, want := keyOrder(m), []string{"b", "c", "a"}; !slices.Equal(got, want) {
+		t.Fatalf("order = %v; want %v", got, want)
+	}
+}
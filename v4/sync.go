@@ -0,0 +1,211 @@
+package orderedmap
+
+import (
+	"iter"
+	"reflect"
+	"sync"
+)
+
+// SyncOrderedMap is a concurrency-safe variant of OrderedMap. Reads take the
+// read lock and mutations take the write lock, so unlike OrderedMap it is
+// safe to share across goroutines without external synchronization.
+type SyncOrderedMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  OrderedMap[K, V]
+}
+
+// NewSyncOrderedMap creates an empty, concurrency-safe ordered map.
+func NewSyncOrderedMap[K comparable, V any]() *SyncOrderedMap[K, V] {
+	return &SyncOrderedMap[K, V]{m: MakeOrderedMap[K, V]()}
+}
+
+// Get returns the value for a key. If the key does not exist, the second
+// return parameter will be false and the value will be the zero value of V.
+func (m *SyncOrderedMap[K, V]) Get(key K) (value V, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Get(key)
+}
+
+// Set will set (or replace) a value for a key. If the key was new, then true
+// will be returned. The returned value will be false if the value was
+// replaced (even if the value was the same).
+func (m *SyncOrderedMap[K, V]) Set(key K, value V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.Set(key, value)
+}
+
+// GetOrDefault returns the value for a key. If the key does not exist,
+// returns the default value instead.
+func (m *SyncOrderedMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.GetOrDefault(key, defaultValue)
+}
+
+// LoadOrStore returns the existing value for the key if present. Otherwise,
+// it stores and returns the given value. The loaded result is true if the
+// value was already present.
+func (m *SyncOrderedMap[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if actual, ok := m.m.kv[key]; ok {
+		return actual, true
+	}
+	m.m.Set(key, value)
+	return value, false
+}
+
+// CompareAndSwap swaps the value for key if its current value equals old,
+// returning true on success. It returns false if the key does not exist or
+// its current value does not equal old.
+func (m *SyncOrderedMap[K, V]) CompareAndSwap(key K, old, new V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	current, ok := m.m.kv[key]
+	if !ok || !reflect.DeepEqual(current, old) {
+		return false
+	}
+	m.m.Set(key, new)
+	return true
+}
+
+// ReplaceKey replaces an existing key with a new key while preserving order
+// of the value. This function will return true if the operation was
+// successful, or false if 'originalKey' is not found OR 'newKey' already
+// exists (which would be an overwrite).
+func (m *SyncOrderedMap[K, V]) ReplaceKey(originalKey, newKey K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.ReplaceKey(originalKey, newKey)
+}
+
+// Len returns the number of elements in the map.
+func (m *SyncOrderedMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Len()
+}
+
+// Has checks if a key exists in the map.
+func (m *SyncOrderedMap[K, V]) Has(key K) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Has(key)
+}
+
+// Delete will remove a key from the map. It will return true if the key was
+// removed (the key did exist).
+func (m *SyncOrderedMap[K, V]) Delete(key K) (didDelete bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.Delete(key)
+}
+
+// Copy returns a new OrderedMap with the same elements. The read lock is
+// held for the duration of the copy, so unlike OrderedMap.Copy the result
+// cannot be mangled by a concurrent write.
+func (m *SyncOrderedMap[K, V]) Copy() *OrderedMap[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Copy()
+}
+
+// CycleSnapshot returns a CycleIterator over a copy of the map's current
+// key/value pairs, copied out while the read lock is held. The returned
+// iterator never reads from the map again, so calling Next concurrently
+// with Set/Delete on m is race-free and, like OrderedMap.CycleSnapshot, the
+// snapshot is unaffected by those later calls. There is no live-mode Cycle
+// on SyncOrderedMap: walking the map's own backing slice without holding
+// the lock for the whole traversal would defeat the locking this type
+// exists to provide.
+func (m *SyncOrderedMap[K, V]) CycleSnapshot() *CycleIterator[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.CycleSnapshot()
+}
+
+// snapshotKeys copies the current key order under the read lock so that
+// iteration below cannot observe a torn state mid-iteration.
+func (m *SyncOrderedMap[K, V]) snapshotKeys() []K {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys := make([]K, len(*m.m.ll))
+	copy(keys, *m.m.ll)
+	return keys
+}
+
+// All returns an iterator that yields all elements in the map starting at
+// the front (oldest Set element). The key order is snapshotted under RLock
+// before any values are yielded.
+func (m *SyncOrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return m.AllFromFront()
+}
+
+// AllFromFront returns an iterator that yields all elements in the map
+// starting at the front (oldest Set element). The key order is snapshotted
+// under RLock before any values are yielded.
+func (m *SyncOrderedMap[K, V]) AllFromFront() iter.Seq2[K, V] {
+	keys := m.snapshotKeys()
+	return func(yield func(key K, value V) bool) {
+		for _, key := range keys {
+			value, ok := m.Get(key)
+			if !ok {
+				continue
+			}
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// AllFromBack returns an iterator that yields all elements in the map
+// starting at the back (most recent Set element). The key order is
+// snapshotted under RLock before any values are yielded.
+func (m *SyncOrderedMap[K, V]) AllFromBack() iter.Seq2[K, V] {
+	keys := m.snapshotKeys()
+	return func(yield func(key K, value V) bool) {
+		for i := len(keys) - 1; i >= 0; i-- {
+			value, ok := m.Get(keys[i])
+			if !ok {
+				continue
+			}
+			if !yield(keys[i], value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator that yields all the keys in the map starting at
+// the front (oldest Set element), snapshotted under RLock.
+func (m *SyncOrderedMap[K, V]) Keys() iter.Seq[K] {
+	keys := m.snapshotKeys()
+	return func(yield func(key K) bool) {
+		for _, key := range keys {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator that yields all the values in the map starting
+// at the front (oldest Set element). The key order is snapshotted under
+// RLock before any values are yielded.
+func (m *SyncOrderedMap[K, V]) Values() iter.Seq[V] {
+	keys := m.snapshotKeys()
+	return func(yield func(value V) bool) {
+		for _, key := range keys {
+			value, ok := m.Get(key)
+			if !ok {
+				continue
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package orderedmap
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSyncOrderedMapBasics(t *testing.T) {
+	m := NewSyncOrderedMap[string, int]()
+
+	if !m.Set("a", 1) {
+		t.Fatalf("Set(a, 1) on new key should return true")
+	}
+	if m.Set("a", 2) {
+		t.Fatalf("Set(a, 2) on existing key should return false")
+	}
+	if v, ok := m.Get("a"); !ok || v != 2 {
+		t.Fatalf("Get(a) = %v, %v; want 2, true", v, ok)
+	}
+	if m.Len() != 1 {
+		t.Fatalf("Len() = %d; want 1", m.Len())
+	}
+	if !m.Delete("a") {
+		t.Fatalf("Delete(a) should return true")
+	}
+	if m.Has("a") {
+		t.Fatalf("Has(a) should be false after Delete")
+	}
+}
+
+func TestSyncOrderedMapLoadOrStore(t *testing.T) {
+	m := NewSyncOrderedMap[string, int]()
+
+	actual, loaded := m.LoadOrStore("a", 1)
+	if loaded || actual != 1 {
+		t.Fatalf("LoadOrStore(a, 1) = %v, %v; want 1, false", actual, loaded)
+	}
+
+	actual, loaded = m.LoadOrStore("a", 2)
+	if !loaded || actual != 1 {
+		t.Fatalf("LoadOrStore(a, 2) = %v, %v; want 1, true", actual, loaded)
+	}
+}
+
+func TestSyncOrderedMapCompareAndSwap(t *testing.T) {
+	m := NewSyncOrderedMap[string, int]()
+	m.Set("a", 1)
+
+	if m.CompareAndSwap("a", 2, 3) {
+		t.Fatalf("CompareAndSwap should fail when old does not match current value")
+	}
+	if !m.CompareAndSwap("a", 1, 3) {
+		t.Fatalf("CompareAndSwap should succeed when old matches current value")
+	}
+	if v, _ := m.Get("a"); v != 3 {
+		t.Fatalf("Get(a) = %d; want 3", v)
+	}
+	if m.CompareAndSwap("missing", 0, 1) {
+		t.Fatalf("CompareAndSwap should fail for a missing key")
+	}
+}
+
+func TestSyncOrderedMapIterationOrder(t *testing.T) {
+	m := NewSyncOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	var keys []string
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	want := []string{"a", "b", "c"}
+	if len(keys) != len(want) {
+		t.Fatalf("All() yielded %v; want %v", keys, want)
+	}
+	for i, k := range want {
+		if keys[i] != k {
+			t.Fatalf("All() yielded %v; want %v", keys, want)
+		}
+	}
+}
+
+func TestSyncOrderedMapConcurrentAccess(t *testing.T) {
+	m := NewSyncOrderedMap[int, int]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.Set(i, i)
+			m.Get(i)
+			for range m.All() {
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if m.Len() != 100 {
+		t.Fatalf("Len() = %d; want 100", m.Len())
+	}
+}